@@ -0,0 +1,58 @@
+package cmd
+
+import (
+	"bufio"
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/jrschumacher/venmo-transaction-export/internal/credential"
+)
+
+var loginCmd = &cobra.Command{
+	Use:   "login",
+	Short: "Save a Venmo session cookie and external ID for future runs",
+	Long: `login walks through extracting the session cookie and external ID
+from an authenticated Venmo web session and saves them to the OS keyring, so
+subsequent runs don't need --cookie-file, --cookie-stdin, or --external-id.
+
+To find these values:
+  1. Sign in to https://account.venmo.com in your browser.
+  2. Open dev tools, go to the Network tab, and reload the page.
+  3. Find the request to /api/stories and copy its "cookie" request header.
+  4. Copy the "externalId" query parameter from that same request's URL.`,
+	RunE: runLogin,
+}
+
+func init() {
+	rootCmd.AddCommand(loginCmd)
+}
+
+func runLogin(cmd *cobra.Command, args []string) error {
+	reader := bufio.NewReader(cmd.InOrStdin())
+	out := cmd.OutOrStdout()
+
+	fmt.Fprint(out, "Paste the Venmo session cookie: ")
+	cookie, err := reader.ReadString('\n')
+	if err != nil {
+		return fmt.Errorf("read cookie: %w", err)
+	}
+
+	fmt.Fprint(out, "Paste the Venmo external ID: ")
+	acctExternalID, err := reader.ReadString('\n')
+	if err != nil {
+		return fmt.Errorf("read external id: %w", err)
+	}
+
+	creds := credential.Credentials{
+		Cookie:     strings.TrimSpace(cookie),
+		ExternalID: strings.TrimSpace(acctExternalID),
+	}
+	if err := credential.Save(creds); err != nil {
+		return fmt.Errorf("save credentials: %w", err)
+	}
+
+	fmt.Fprintln(out, "Saved. Future runs won't need --cookie-file, --cookie-stdin, or --external-id.")
+	return nil
+}
@@ -0,0 +1,272 @@
+// Package cmd implements the venmo-export CLI.
+package cmd
+
+import (
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"os"
+	"os/signal"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/jrschumacher/venmo-transaction-export/export"
+	"github.com/jrschumacher/venmo-transaction-export/internal/credential"
+	"github.com/jrschumacher/venmo-transaction-export/state"
+	"github.com/jrschumacher/venmo-transaction-export/venmo"
+)
+
+var (
+	externalID  string
+	cookieFile  string
+	cookieStdin bool
+	from        string
+	to          string
+	format      string
+	output      string
+	logLevel    string
+	stateFile   string
+	fullRefresh bool
+	retryMax    int
+	rateLimit   float64
+)
+
+// rootCmd fetches and exports an account's Venmo transaction history.
+var rootCmd = &cobra.Command{
+	Use:   "venmo-export",
+	Short: "Export Venmo transaction history",
+	Long: `venmo-export fetches an account's Venmo transaction history and writes
+it out in a variety of personal-finance formats.
+
+The session cookie can be provided via --cookie-file, --cookie-stdin, the
+VENMO_COOKIE environment variable, or saved ahead of time with
+"venmo-export login".`,
+	RunE: runExport,
+}
+
+// Execute runs the root command, exiting the process on error.
+func Execute() {
+	if err := rootCmd.Execute(); err != nil {
+		os.Exit(1)
+	}
+}
+
+func init() {
+	rootCmd.Flags().StringVar(&externalID, "external-id", "", "Venmo account external ID (falls back to saved login)")
+	rootCmd.Flags().StringVar(&cookieFile, "cookie-file", "", "path to a file containing the Venmo session cookie")
+	rootCmd.Flags().BoolVar(&cookieStdin, "cookie-stdin", false, "read the Venmo session cookie from stdin")
+	rootCmd.Flags().StringVar(&from, "from", "", "only include transactions on or after this date (YYYY-MM-DD)")
+	rootCmd.Flags().StringVar(&to, "to", "", "only include transactions on or before this date (YYYY-MM-DD)")
+	rootCmd.Flags().StringVar(&format, "format", string(export.FormatCSV), "output format: csv, json, qif, ofx, ledger")
+	rootCmd.Flags().StringVar(&output, "output", "-", "output file path, or - for stdout")
+	rootCmd.Flags().StringVar(&logLevel, "log-level", "info", "log level: debug, info, warn, error")
+	rootCmd.Flags().StringVar(&stateFile, "state-file", "venmo-export-state.json", "path to the incremental sync state file")
+	rootCmd.Flags().BoolVar(&fullRefresh, "full-refresh", false, "ignore persisted sync state and refetch from the beginning")
+	rootCmd.Flags().IntVar(&retryMax, "retry-max", 5, "maximum retry attempts for transient HTTP errors")
+	rootCmd.Flags().Float64Var(&rateLimit, "rate-limit", 0, "maximum requests per second to the Venmo API (0 = unlimited)")
+}
+
+func runExport(cmd *cobra.Command, args []string) error {
+	ctx, stop := signal.NotifyContext(cmd.Context(), os.Interrupt)
+	defer stop()
+
+	logger, err := newLogger(logLevel)
+	if err != nil {
+		return err
+	}
+
+	cookie, err := resolveCookie()
+	if err != nil {
+		return err
+	}
+	acctExternalID, err := resolveExternalID()
+	if err != nil {
+		return err
+	}
+
+	fromParsed, err := parseOptionalDate(from)
+	if err != nil {
+		return fmt.Errorf("parse --from: %w", err)
+	}
+	toParsed, err := parseOptionalDate(to)
+	if err != nil {
+		return fmt.Errorf("parse --to: %w", err)
+	}
+	if !toParsed.IsZero() {
+		// --to is inclusive of the whole day.
+		toParsed = toParsed.Add(24*time.Hour - time.Nanosecond)
+	}
+
+	store := state.NewFileStore(stateFile)
+	syncState := state.State{}
+	if !fullRefresh {
+		syncState, err = store.Load()
+		if err != nil {
+			return fmt.Errorf("load sync state: %w", err)
+		}
+	}
+
+	// A persisted cursor means a previous run already wrote output for
+	// everything before it; append instead of truncating, so an
+	// incremental run doesn't destroy rows it isn't re-fetching.
+	appending := syncState.LastID != "" && output != "-"
+
+	out, closeOut, err := openOutput(output, appending)
+	if err != nil {
+		return err
+	}
+	defer closeOut()
+
+	exporter, err := export.New(export.Format(format), out, appending)
+	if err != nil {
+		return fmt.Errorf("create exporter: %w", err)
+	}
+
+	retryCfg := venmo.DefaultRetryConfig
+	retryCfg.MaxAttempts = retryMax
+	retryCfg.RateLimit = rateLimit
+	httpClient := &http.Client{Transport: venmo.NewRetryingTransport(nil, retryCfg)}
+
+	client := venmo.NewClient(cookie, acctExternalID, venmo.WithLogger(logger), venmo.WithHTTPClient(httpClient))
+	pager := client.Transactions().Pager(ctx)
+
+	seen := make(map[string]bool)
+	var newest state.State
+
+pageLoop:
+	for pager.Next() {
+		for _, txn := range pager.Page() {
+			switch decide(txn, syncState.LastID, fromParsed, toParsed, seen) {
+			case decisionStopCursor:
+				logger.Info("reached last synced transaction, stopping")
+				break pageLoop
+			case decisionStopDateRange:
+				logger.Info("reached start of date range, stopping")
+				break pageLoop
+			case decisionSkip:
+				continue
+			}
+			seen[txn.ID] = true
+
+			if newest.LastID == "" {
+				newest = state.State{LastID: txn.ID, SyncedAt: txn.Date}
+			}
+
+			if err := exporter.Write(txn); err != nil {
+				return fmt.Errorf("write transaction: %w", err)
+			}
+		}
+	}
+
+	// Flush whatever was written and persist the cursor before reporting a
+	// fetch error, so an interrupted or failed run can resume cleanly.
+	pagerErr := pager.Err()
+	flushErr := exporter.Flush()
+	var saveErr error
+	if newest.LastID != "" {
+		saveErr = store.Save(newest)
+	}
+
+	if pagerErr != nil {
+		return fmt.Errorf("fetch transactions: %w", pagerErr)
+	}
+	if flushErr != nil {
+		return fmt.Errorf("flush output: %w", flushErr)
+	}
+	if saveErr != nil {
+		return fmt.Errorf("save sync state: %w", saveErr)
+	}
+
+	logger.Info("export complete")
+	return nil
+}
+
+// resolveCookie resolves the Venmo session cookie from, in order of
+// precedence: --cookie-stdin, --cookie-file, VENMO_COOKIE, and a saved
+// `venmo-export login` session.
+func resolveCookie() (string, error) {
+	switch {
+	case cookieStdin:
+		data, err := io.ReadAll(os.Stdin)
+		if err != nil {
+			return "", fmt.Errorf("read cookie from stdin: %w", err)
+		}
+		return strings.TrimSpace(string(data)), nil
+	case cookieFile != "":
+		data, err := os.ReadFile(cookieFile)
+		if err != nil {
+			return "", fmt.Errorf("read cookie file: %w", err)
+		}
+		return strings.TrimSpace(string(data)), nil
+	case os.Getenv("VENMO_COOKIE") != "":
+		return os.Getenv("VENMO_COOKIE"), nil
+	default:
+		creds, ok, err := credential.Load()
+		if err != nil {
+			return "", fmt.Errorf("load saved login: %w", err)
+		}
+		if !ok {
+			return "", fmt.Errorf("no cookie provided: use --cookie-file, --cookie-stdin, VENMO_COOKIE, or run \"venmo-export login\"")
+		}
+		return creds.Cookie, nil
+	}
+}
+
+// resolveExternalID resolves the account external ID from --external-id,
+// falling back to a saved `venmo-export login` session.
+func resolveExternalID() (string, error) {
+	if externalID != "" {
+		return externalID, nil
+	}
+	creds, ok, err := credential.Load()
+	if err != nil {
+		return "", fmt.Errorf("load saved login: %w", err)
+	}
+	if !ok || creds.ExternalID == "" {
+		return "", fmt.Errorf("no external id provided: use --external-id or run \"venmo-export login\"")
+	}
+	return creds.ExternalID, nil
+}
+
+// openOutput opens the destination for export output. Passing "-" writes to
+// stdout, in which case the returned close function is a no-op. When
+// appending, the file is opened for append rather than truncated, so an
+// incremental run's output lands after whatever a previous run wrote.
+func openOutput(path string, appending bool) (io.Writer, func(), error) {
+	if path == "-" {
+		return os.Stdout, func() {}, nil
+	}
+	flags := os.O_WRONLY | os.O_CREATE
+	if appending {
+		flags |= os.O_APPEND
+	} else {
+		flags |= os.O_TRUNC
+	}
+	f, err := os.OpenFile(path, flags, 0o644)
+	if err != nil {
+		return nil, nil, fmt.Errorf("open output file: %w", err)
+	}
+	return f, func() { _ = f.Close() }, nil
+}
+
+// parseOptionalDate parses a YYYY-MM-DD flag value, returning the zero time
+// if raw is empty.
+func parseOptionalDate(raw string) (time.Time, error) {
+	if raw == "" {
+		return time.Time{}, nil
+	}
+	return time.Parse("2006-01-02", raw)
+}
+
+// newLogger builds a logger at the requested level, writing to stderr so it
+// never mixes with export output on stdout.
+func newLogger(level string) (*slog.Logger, error) {
+	var lvl slog.Level
+	if err := lvl.UnmarshalText([]byte(level)); err != nil {
+		return nil, fmt.Errorf("parse --log-level: %w", err)
+	}
+	return slog.New(slog.NewTextHandler(os.Stderr, &slog.HandlerOptions{Level: lvl})), nil
+}
@@ -0,0 +1,44 @@
+package cmd
+
+import (
+	"time"
+
+	"github.com/jrschumacher/venmo-transaction-export/venmo"
+)
+
+// syncDecision is what the main fetch loop should do with a transaction
+// during an incremental, date-bounded sync.
+type syncDecision int
+
+const (
+	// decisionWrite means the transaction is new and within range: write it.
+	decisionWrite syncDecision = iota
+	// decisionSkip means the transaction is already accounted for (out of
+	// the --to window, or a duplicate seen earlier this run); keep paging.
+	decisionSkip
+	// decisionStopCursor means the transaction was the last one synced by a
+	// previous run; stop paging.
+	decisionStopCursor
+	// decisionStopDateRange means the transaction is older than --from;
+	// stop paging.
+	decisionStopDateRange
+)
+
+// decide applies the incremental-sync cursor check, the --from/--to date
+// window, and ID-level dedup to a single transaction, in the same order the
+// main fetch loop enforces them. seen is mutated by the caller once it acts
+// on a decisionWrite; decide itself only reads it.
+func decide(txn venmo.Transaction, lastID string, from, to time.Time, seen map[string]bool) syncDecision {
+	switch {
+	case lastID != "" && txn.ID == lastID:
+		return decisionStopCursor
+	case !from.IsZero() && txn.Date.Before(from):
+		return decisionStopDateRange
+	case !to.IsZero() && txn.Date.After(to):
+		return decisionSkip
+	case seen[txn.ID]:
+		return decisionSkip
+	default:
+		return decisionWrite
+	}
+}
@@ -0,0 +1,77 @@
+package cmd
+
+import (
+	"testing"
+	"time"
+
+	"github.com/jrschumacher/venmo-transaction-export/venmo"
+)
+
+func TestDecide(t *testing.T) {
+	day := func(s string) time.Time {
+		t, err := time.Parse("2006-01-02", s)
+		if err != nil {
+			panic(err)
+		}
+		return t
+	}
+
+	tests := []struct {
+		name   string
+		txn    venmo.Transaction
+		lastID string
+		from   time.Time
+		to     time.Time
+		seen   map[string]bool
+		want   syncDecision
+	}{
+		{
+			name: "no bounds, new transaction is written",
+			txn:  venmo.Transaction{ID: "2", Date: day("2026-07-20")},
+			want: decisionWrite,
+		},
+		{
+			name:   "matches persisted cursor, stop",
+			txn:    venmo.Transaction{ID: "1", Date: day("2026-07-20")},
+			lastID: "1",
+			want:   decisionStopCursor,
+		},
+		{
+			name: "older than --from, stop",
+			txn:  venmo.Transaction{ID: "2", Date: day("2026-07-01")},
+			from: day("2026-07-10"),
+			want: decisionStopDateRange,
+		},
+		{
+			name: "newer than --to, skip and keep paging",
+			txn:  venmo.Transaction{ID: "2", Date: day("2026-07-20")},
+			to:   day("2026-07-10"),
+			want: decisionSkip,
+		},
+		{
+			name: "already seen this run, skip",
+			txn:  venmo.Transaction{ID: "2", Date: day("2026-07-20")},
+			seen: map[string]bool{"2": true},
+			want: decisionSkip,
+		},
+		{
+			name:   "empty cursor never matches an empty transaction ID",
+			txn:    venmo.Transaction{ID: "", Date: day("2026-07-20")},
+			lastID: "",
+			want:   decisionWrite,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			seen := tt.seen
+			if seen == nil {
+				seen = map[string]bool{}
+			}
+			got := decide(tt.txn, tt.lastID, tt.from, tt.to, seen)
+			if got != tt.want {
+				t.Errorf("decide() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
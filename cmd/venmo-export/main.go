@@ -0,0 +1,8 @@
+// Command venmo-export dumps a Venmo account's transaction history.
+package main
+
+import "github.com/jrschumacher/venmo-transaction-export/cmd/venmo-export/cmd"
+
+func main() {
+	cmd.Execute()
+}
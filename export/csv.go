@@ -0,0 +1,42 @@
+package export
+
+import (
+	"encoding/csv"
+	"io"
+	"strconv"
+	"time"
+
+	"github.com/jrschumacher/venmo-transaction-export/venmo"
+)
+
+// csvExporter writes transactions as CSV, matching the tool's original
+// output format.
+type csvExporter struct {
+	w *csv.Writer
+}
+
+// newCSVExporter writes the column header, unless appending to an output
+// that already has one from a previous incremental run.
+func newCSVExporter(w io.Writer, appending bool) (*csvExporter, error) {
+	cw := csv.NewWriter(w)
+	if !appending {
+		if err := cw.Write([]string{"Amount", "Date", "Type", "Note"}); err != nil {
+			return nil, err
+		}
+	}
+	return &csvExporter{w: cw}, nil
+}
+
+func (e *csvExporter) Write(txn venmo.Transaction) error {
+	return e.w.Write([]string{
+		strconv.FormatFloat(txn.Amount, 'f', 2, 64),
+		txn.Date.Format(time.RFC3339),
+		txn.Type,
+		txn.Note,
+	})
+}
+
+func (e *csvExporter) Flush() error {
+	e.w.Flush()
+	return e.w.Error()
+}
@@ -0,0 +1,52 @@
+// Package export converts normalized Venmo transactions into the file
+// formats expected by downstream personal-finance tools.
+package export
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/jrschumacher/venmo-transaction-export/venmo"
+)
+
+// Exporter writes a stream of transactions to an underlying writer in a
+// particular output format. Implementations may buffer; call Flush once the
+// stream is complete to guarantee everything has been written.
+type Exporter interface {
+	Write(venmo.Transaction) error
+	Flush() error
+}
+
+// Format identifies a supported output format, selected via the -format
+// flag.
+type Format string
+
+// Supported output formats.
+const (
+	FormatCSV    Format = "csv"
+	FormatJSON   Format = "json"
+	FormatQIF    Format = "qif"
+	FormatOFX    Format = "ofx"
+	FormatLedger Format = "ledger"
+)
+
+// New constructs the Exporter for the given format, writing to w. Pass
+// appending true when w already holds output from a previous incremental
+// run, so formats with a header or document preamble (CSV, QIF, OFX) don't
+// repeat or corrupt it.
+func New(format Format, w io.Writer, appending bool) (Exporter, error) {
+	switch format {
+	case FormatCSV:
+		return newCSVExporter(w, appending)
+	case FormatJSON:
+		return newJSONExporter(w), nil
+	case FormatQIF:
+		return newQIFExporter(w, appending), nil
+	case FormatOFX:
+		return newOFXExporter(w, appending)
+	case FormatLedger:
+		return newLedgerExporter(w), nil
+	default:
+		return nil, fmt.Errorf("unsupported format: %q", format)
+	}
+}
@@ -0,0 +1,130 @@
+package export
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/jrschumacher/venmo-transaction-export/venmo"
+)
+
+var testTxn = venmo.Transaction{
+	ID:     "1",
+	Amount: -12.5,
+	Date:   time.Date(2026, 7, 20, 10, 0, 0, 0, time.UTC),
+	Type:   "Payment",
+	Note:   "To Bob | lunch",
+}
+
+func TestCSVExporter(t *testing.T) {
+	tests := []struct {
+		name      string
+		appending bool
+		want      string
+	}{
+		{
+			name:      "fresh file writes header",
+			appending: false,
+			want:      "Amount,Date,Type,Note\n-12.50,2026-07-20T10:00:00Z,Payment,To Bob | lunch\n",
+		},
+		{
+			name:      "appending skips header",
+			appending: true,
+			want:      "-12.50,2026-07-20T10:00:00Z,Payment,To Bob | lunch\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var buf bytes.Buffer
+			exp, err := newCSVExporter(&buf, tt.appending)
+			if err != nil {
+				t.Fatalf("newCSVExporter() error = %v", err)
+			}
+			if err := exp.Write(testTxn); err != nil {
+				t.Fatalf("Write() error = %v", err)
+			}
+			if err := exp.Flush(); err != nil {
+				t.Fatalf("Flush() error = %v", err)
+			}
+			if got := buf.String(); got != tt.want {
+				t.Errorf("output = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestQIFExporter(t *testing.T) {
+	tests := []struct {
+		name      string
+		appending bool
+		want      string
+	}{
+		{
+			name:      "fresh file writes header",
+			appending: false,
+			want:      "!Type:Cash\nD07/20/2026\nT-12.50\nMTo Bob | lunch\n^\n",
+		},
+		{
+			name:      "appending skips header",
+			appending: true,
+			want:      "D07/20/2026\nT-12.50\nMTo Bob | lunch\n^\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var buf bytes.Buffer
+			exp := newQIFExporter(&buf, tt.appending)
+			if err := exp.Write(testTxn); err != nil {
+				t.Fatalf("Write() error = %v", err)
+			}
+			if err := exp.Flush(); err != nil {
+				t.Fatalf("Flush() error = %v", err)
+			}
+			if got := buf.String(); got != tt.want {
+				t.Errorf("output = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestJSONExporter(t *testing.T) {
+	var buf bytes.Buffer
+	exp := newJSONExporter(&buf)
+	if err := exp.Write(testTxn); err != nil {
+		t.Fatalf("Write() error = %v", err)
+	}
+	if err := exp.Flush(); err != nil {
+		t.Fatalf("Flush() error = %v", err)
+	}
+
+	want := `{"id":"1","amount":-12.5,"date":"2026-07-20T10:00:00Z","type":"Payment","note":"To Bob | lunch"}` + "\n"
+	if got := buf.String(); got != want {
+		t.Errorf("output = %q, want %q", got, want)
+	}
+}
+
+func TestLedgerExporter(t *testing.T) {
+	var buf bytes.Buffer
+	exp := newLedgerExporter(&buf)
+	if err := exp.Write(testTxn); err != nil {
+		t.Fatalf("Write() error = %v", err)
+	}
+	if err := exp.Flush(); err != nil {
+		t.Fatalf("Flush() error = %v", err)
+	}
+
+	want := "2026-07-20 To Bob | lunch\n    Assets:Venmo  $-12.50\n    Expenses:Unknown  $12.50\n\n"
+	if got := buf.String(); got != want {
+		t.Errorf("output = %q, want %q", got, want)
+	}
+}
+
+func TestNew_UnsupportedFormat(t *testing.T) {
+	var buf bytes.Buffer
+	_, err := New(Format("bogus"), &buf, false)
+	if err == nil {
+		t.Fatal("New() error = nil, want an error for an unsupported format")
+	}
+}
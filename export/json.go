@@ -0,0 +1,26 @@
+package export
+
+import (
+	"encoding/json"
+	"io"
+
+	"github.com/jrschumacher/venmo-transaction-export/venmo"
+)
+
+// jsonExporter writes one JSON object per line (JSON Lines), so consumers
+// can stream large histories without loading the whole file into memory.
+type jsonExporter struct {
+	enc *json.Encoder
+}
+
+func newJSONExporter(w io.Writer) *jsonExporter {
+	return &jsonExporter{enc: json.NewEncoder(w)}
+}
+
+func (e *jsonExporter) Write(txn venmo.Transaction) error {
+	return e.enc.Encode(txn)
+}
+
+func (e *jsonExporter) Flush() error {
+	return nil
+}
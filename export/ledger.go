@@ -0,0 +1,34 @@
+package export
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/jrschumacher/venmo-transaction-export/venmo"
+)
+
+// ledgerExporter writes plaintext double-entry records in the format
+// understood by ledger and hledger. The offsetting account is always
+// Expenses:Unknown, since Venmo doesn't report merchant categories; users
+// are expected to reclassify via their usual ledger workflow.
+type ledgerExporter struct {
+	w io.Writer
+}
+
+func newLedgerExporter(w io.Writer) *ledgerExporter {
+	return &ledgerExporter{w: w}
+}
+
+func (e *ledgerExporter) Write(txn venmo.Transaction) error {
+	payee := txn.Note
+	if payee == "" {
+		payee = txn.Type
+	}
+	_, err := fmt.Fprintf(e.w, "%s %s\n    Assets:Venmo  $%.2f\n    Expenses:Unknown  $%.2f\n\n",
+		txn.Date.Format("2006-01-02"), payee, txn.Amount, -txn.Amount)
+	return err
+}
+
+func (e *ledgerExporter) Flush() error {
+	return nil
+}
@@ -0,0 +1,88 @@
+package export
+
+import (
+	"encoding/xml"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/jrschumacher/venmo-transaction-export/venmo"
+)
+
+// ofxExporter accumulates transactions and writes a single OFX 2.x (XML)
+// document on Flush, since the format's BANKTRANLIST needs a closing tag
+// written only once every transaction is known.
+type ofxExporter struct {
+	w    io.Writer
+	txns []venmo.Transaction
+}
+
+// newOFXExporter rejects appending: OFX wraps every transaction in a single
+// BANKTRANLIST root element written once on Flush, so it can't be
+// incrementally appended to an existing file the way CSV/QIF/JSON/Ledger
+// can. Incremental syncs must use --full-refresh or a different --format.
+func newOFXExporter(w io.Writer, appending bool) (*ofxExporter, error) {
+	if appending {
+		return nil, fmt.Errorf("format ofx does not support incremental appends; use --full-refresh or a different --format")
+	}
+	return &ofxExporter{w: w}, nil
+}
+
+func (e *ofxExporter) Write(txn venmo.Transaction) error {
+	e.txns = append(e.txns, txn)
+	return nil
+}
+
+func (e *ofxExporter) Flush() error {
+	var b strings.Builder
+	b.WriteString("<?xml version=\"1.0\" encoding=\"UTF-8\"?>\n")
+	b.WriteString("<?OFX OFXHEADER=\"200\" VERSION=\"211\" SECURITY=\"NONE\" OLDFILEUID=\"NONE\" NEWFILEUID=\"NONE\"?>\n")
+	b.WriteString("<OFX>\n<BANKMSGSRSV1>\n<STMTTRNRS>\n<STMTRS>\n<BANKTRANLIST>\n")
+	for _, txn := range e.txns {
+		b.WriteString("<STMTTRN>\n")
+		writeOFXElement(&b, "TRNTYPE", ofxTrnType(txn))
+		fmt.Fprintf(&b, "<DTPOSTED>%s</DTPOSTED>\n", txn.Date.Format("20060102150405"))
+		fmt.Fprintf(&b, "<TRNAMT>%.2f</TRNAMT>\n", txn.Amount)
+		writeOFXElement(&b, "FITID", txn.ID)
+		writeOFXElement(&b, "NAME", ofxName(txn))
+		writeOFXElement(&b, "MEMO", txn.Note)
+		b.WriteString("</STMTTRN>\n")
+	}
+	b.WriteString("</BANKTRANLIST>\n</STMTRS>\n</STMTTRNRS>\n</BANKMSGSRSV1>\n</OFX>\n")
+
+	_, err := io.WriteString(e.w, b.String())
+	return err
+}
+
+// ofxName returns the label OFX importers show as the transaction's primary
+// payee, preferring the payment counterparty over the generic transaction
+// type.
+func ofxName(txn venmo.Transaction) string {
+	if txn.Counterparty != "" {
+		return txn.Counterparty
+	}
+	return txn.Type
+}
+
+// ofxTrnType maps a Transaction to the OFX TRNTYPE enum.
+func ofxTrnType(txn venmo.Transaction) string {
+	switch {
+	case txn.Type == "Transfer":
+		return "XFER"
+	case txn.Amount < 0:
+		return "DEBIT"
+	default:
+		return "CREDIT"
+	}
+}
+
+// writeOFXElement writes <tag>content</tag>, escaping content since OFX 2.x
+// is real XML and Venmo notes are free text that can contain "&", "<", and
+// similar characters.
+func writeOFXElement(b *strings.Builder, tag, content string) {
+	fmt.Fprintf(b, "<%s>", tag)
+	if err := xml.EscapeText(b, []byte(content)); err != nil {
+		b.WriteString(content)
+	}
+	fmt.Fprintf(b, "</%s>\n", tag)
+}
@@ -0,0 +1,77 @@
+package export
+
+import (
+	"bytes"
+	"encoding/xml"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/jrschumacher/venmo-transaction-export/venmo"
+)
+
+func TestOFXExporter_ProducesWellFormedXML(t *testing.T) {
+	txn := venmo.Transaction{
+		ID:           "1",
+		Amount:       -12.5,
+		Date:         time.Date(2026, 7, 20, 10, 0, 0, 0, time.UTC),
+		Type:         "Payment",
+		Note:         "Tom & Jerry's <lunch>",
+		Counterparty: "Tom & Jerry's",
+	}
+
+	var buf bytes.Buffer
+	exp, err := newOFXExporter(&buf, false)
+	if err != nil {
+		t.Fatalf("newOFXExporter() error = %v", err)
+	}
+	if err := exp.Write(txn); err != nil {
+		t.Fatalf("Write() error = %v", err)
+	}
+	if err := exp.Flush(); err != nil {
+		t.Fatalf("Flush() error = %v", err)
+	}
+
+	var doc struct {
+		XMLName xml.Name `xml:"OFX"`
+	}
+	if err := xml.Unmarshal(buf.Bytes(), &doc); err != nil {
+		t.Fatalf("output is not well-formed XML: %v\n%s", err, buf.String())
+	}
+
+	out := buf.String()
+	if !strings.Contains(out, "<NAME>Tom &amp; Jerry&#39;s</NAME>") {
+		t.Errorf("NAME not escaped, got:\n%s", out)
+	}
+	if !strings.Contains(out, "<MEMO>Tom &amp; Jerry&#39;s &lt;lunch&gt;</MEMO>") {
+		t.Errorf("MEMO not escaped, got:\n%s", out)
+	}
+}
+
+func TestOFXExporter_NameFallsBackToType(t *testing.T) {
+	txn := venmo.Transaction{ID: "2", Type: "Transfer", Date: time.Date(2026, 7, 21, 9, 0, 0, 0, time.UTC)}
+
+	var buf bytes.Buffer
+	exp, err := newOFXExporter(&buf, false)
+	if err != nil {
+		t.Fatalf("newOFXExporter() error = %v", err)
+	}
+	if err := exp.Write(txn); err != nil {
+		t.Fatalf("Write() error = %v", err)
+	}
+	if err := exp.Flush(); err != nil {
+		t.Fatalf("Flush() error = %v", err)
+	}
+
+	if !strings.Contains(buf.String(), "<NAME>Transfer</NAME>") {
+		t.Errorf("expected NAME to fall back to Type, got:\n%s", buf.String())
+	}
+}
+
+func TestOFXExporter_RejectsAppend(t *testing.T) {
+	var buf bytes.Buffer
+	_, err := newOFXExporter(&buf, true)
+	if err == nil {
+		t.Fatal("newOFXExporter(appending=true) error = nil, want an error")
+	}
+}
@@ -0,0 +1,37 @@
+package export
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/jrschumacher/venmo-transaction-export/venmo"
+)
+
+// qifExporter writes Quicken Interchange Format cash transactions, the
+// format expected by most desktop personal-finance importers.
+type qifExporter struct {
+	w           io.Writer
+	wroteHeader bool
+}
+
+// newQIFExporter writes the "!Type:Cash" header before the first
+// transaction, unless appending to an output that already has one from a
+// previous incremental run.
+func newQIFExporter(w io.Writer, appending bool) *qifExporter {
+	return &qifExporter{w: w, wroteHeader: appending}
+}
+
+func (e *qifExporter) Write(txn venmo.Transaction) error {
+	if !e.wroteHeader {
+		if _, err := fmt.Fprintln(e.w, "!Type:Cash"); err != nil {
+			return err
+		}
+		e.wroteHeader = true
+	}
+	_, err := fmt.Fprintf(e.w, "D%s\nT%.2f\nM%s\n^\n", txn.Date.Format("01/02/2006"), txn.Amount, txn.Note)
+	return err
+}
+
+func (e *qifExporter) Flush() error {
+	return nil
+}
@@ -0,0 +1,53 @@
+// Package credential stores the cookie and external ID captured by
+// `venmo-export login` in the OS keyring, so subsequent runs don't need to
+// pass them on the command line.
+package credential
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/zalando/go-keyring"
+)
+
+const (
+	service       = "venmo-export"
+	cookieKey     = "cookie"
+	externalIDKey = "external-id"
+)
+
+// Credentials are the values captured by `venmo-export login`.
+type Credentials struct {
+	Cookie     string
+	ExternalID string
+}
+
+// Save stores Credentials in the OS keyring.
+func Save(creds Credentials) error {
+	if err := keyring.Set(service, cookieKey, creds.Cookie); err != nil {
+		return fmt.Errorf("save cookie: %w", err)
+	}
+	if err := keyring.Set(service, externalIDKey, creds.ExternalID); err != nil {
+		return fmt.Errorf("save external id: %w", err)
+	}
+	return nil
+}
+
+// Load retrieves Credentials previously stored with Save. ok is false if
+// `venmo-export login` hasn't been run yet.
+func Load() (creds Credentials, ok bool, err error) {
+	cookie, err := keyring.Get(service, cookieKey)
+	if errors.Is(err, keyring.ErrNotFound) {
+		return Credentials{}, false, nil
+	}
+	if err != nil {
+		return Credentials{}, false, fmt.Errorf("load cookie: %w", err)
+	}
+
+	externalID, err := keyring.Get(service, externalIDKey)
+	if err != nil {
+		return Credentials{}, false, fmt.Errorf("load external id: %w", err)
+	}
+
+	return Credentials{Cookie: cookie, ExternalID: externalID}, true, nil
+}
@@ -0,0 +1,48 @@
+package state
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+)
+
+// FileStore persists State as a JSON file on the local filesystem.
+type FileStore struct {
+	path string
+}
+
+// NewFileStore returns a FileStore that reads and writes State at path.
+func NewFileStore(path string) *FileStore {
+	return &FileStore{path: path}
+}
+
+// Load reads State from disk, returning a zero State if the file doesn't
+// exist yet, which is the case on a project's first run.
+func (s *FileStore) Load() (State, error) {
+	data, err := os.ReadFile(s.path)
+	if errors.Is(err, os.ErrNotExist) {
+		return State{}, nil
+	}
+	if err != nil {
+		return State{}, fmt.Errorf("read state file: %w", err)
+	}
+
+	var st State
+	if err := json.Unmarshal(data, &st); err != nil {
+		return State{}, fmt.Errorf("parse state file: %w", err)
+	}
+	return st, nil
+}
+
+// Save writes State to disk as JSON, overwriting any previous contents.
+func (s *FileStore) Save(st State) error {
+	data, err := json.MarshalIndent(st, "", "  ")
+	if err != nil {
+		return fmt.Errorf("marshal state: %w", err)
+	}
+	if err := os.WriteFile(s.path, data, 0o600); err != nil {
+		return fmt.Errorf("write state file: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,26 @@
+// Package state persists incremental sync progress between runs, so a
+// scheduled export can resume where the previous run left off instead of
+// re-fetching a whole account's history every time.
+package state
+
+import "time"
+
+// State is the sync progress persisted between runs.
+type State struct {
+	// LastID is the most recent transaction ID seen by the previous run.
+	// A subsequent run stops paging once it reaches this ID.
+	LastID string `json:"lastId"`
+	// SyncedAt is the timestamp of the transaction at LastID.
+	SyncedAt time.Time `json:"syncedAt"`
+}
+
+// Store loads and saves State. The default implementation is FileStore;
+// callers that need a shared or higher-durability backend (SQLite, a cloud
+// KV store, etc.) can implement Store themselves.
+type Store interface {
+	// Load returns the persisted State, or a zero State if none has been
+	// saved yet.
+	Load() (State, error)
+	// Save persists State, overwriting whatever was previously stored.
+	Save(State) error
+}
@@ -0,0 +1,61 @@
+// Package venmo provides a client for Venmo's private stories API, the
+// undocumented endpoint backing the "transaction history" view in the
+// Venmo web app. It is not an official Venmo SDK.
+package venmo
+
+import (
+	"io"
+	"log/slog"
+	"net/http"
+)
+
+// storiesURL is the Venmo endpoint that backs the account activity feed.
+const storiesURL = "https://account.venmo.com/api/stories?feedType=me"
+
+// Client is a low-level HTTP client authenticated against a Venmo web
+// session. Construct one with NewClient and build higher-level services,
+// such as TransactionService, on top of it.
+type Client struct {
+	cookie     string
+	externalID string
+	httpClient *http.Client
+	logger     *slog.Logger
+}
+
+// Option configures a Client constructed by NewClient.
+type Option func(*Client)
+
+// WithHTTPClient overrides the http.Client used for outgoing requests. This
+// is the extension point for callers that need custom transports, e.g. for
+// retries or rate limiting.
+func WithHTTPClient(httpClient *http.Client) Option {
+	return func(c *Client) { c.httpClient = httpClient }
+}
+
+// WithLogger overrides the Client's logger, which defaults to a no-op
+// handler. Pass a configured *slog.Logger to surface request-level
+// diagnostics.
+func WithLogger(logger *slog.Logger) Option {
+	return func(c *Client) { c.logger = logger }
+}
+
+// NewClient constructs a Client authenticated with a Venmo session cookie
+// and the account's external ID, both of which must be extracted from an
+// authenticated browser session (see the `login` CLI subcommand).
+func NewClient(cookie, externalID string, opts ...Option) *Client {
+	c := &Client{
+		cookie:     cookie,
+		externalID: externalID,
+		httpClient: http.DefaultClient,
+		logger:     slog.New(slog.NewTextHandler(io.Discard, nil)),
+	}
+	for _, opt := range opts {
+		opt(c)
+	}
+	return c
+}
+
+// Transactions returns the TransactionService backed by this Client.
+func (c *Client) Transactions() *TransactionService {
+	return &TransactionService{client: c}
+}
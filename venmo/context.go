@@ -0,0 +1,22 @@
+package venmo
+
+import "context"
+
+type contextKey int
+
+const requestIDKey contextKey = iota
+
+// WithRequestID returns a copy of ctx carrying a caller-supplied correlation
+// ID. The Client attaches it to outgoing requests as the X-Request-Id
+// header, which makes it easier to trace a single logical operation (e.g. a
+// scheduled sync run) across logs on both sides.
+func WithRequestID(ctx context.Context, id string) context.Context {
+	return context.WithValue(ctx, requestIDKey, id)
+}
+
+// requestIDFromContext returns the correlation ID previously attached with
+// WithRequestID, if any.
+func requestIDFromContext(ctx context.Context) (string, bool) {
+	id, ok := ctx.Value(requestIDKey).(string)
+	return id, ok
+}
@@ -0,0 +1,65 @@
+package venmo
+
+import "context"
+
+// Pager walks every page of a TransactionService's results in order,
+// following the API's nextId cursor. It follows the same usage pattern as
+// bufio.Scanner: call Next in a loop, read Page while it returns true, then
+// check Err once the loop ends.
+//
+//	pager := svc.Pager(ctx)
+//	for pager.Next() {
+//		for _, txn := range pager.Page() {
+//			...
+//		}
+//	}
+//	if err := pager.Err(); err != nil {
+//		...
+//	}
+type Pager struct {
+	ctx context.Context
+	svc *TransactionService
+
+	started bool
+	nextID  string
+	page    []Transaction
+	err     error
+}
+
+// Next fetches the next page of transactions. It returns false when there
+// are no more pages, the context is done, or a request fails; call Err to
+// distinguish the latter two from ordinary exhaustion.
+func (p *Pager) Next() bool {
+	if p.err != nil {
+		return false
+	}
+	if p.started && p.nextID == "" {
+		return false
+	}
+	if err := p.ctx.Err(); err != nil {
+		p.err = err
+		return false
+	}
+
+	page, nextID, err := p.svc.List(p.ctx, ListOptions{NextID: p.nextID})
+	if err != nil {
+		p.err = err
+		return false
+	}
+
+	p.started = true
+	p.page = page
+	p.nextID = nextID
+	return true
+}
+
+// Page returns the page of transactions fetched by the most recent call to
+// Next.
+func (p *Pager) Page() []Transaction {
+	return p.page
+}
+
+// Err returns the first error encountered by Next, if any.
+func (p *Pager) Err() error {
+	return p.err
+}
@@ -0,0 +1,99 @@
+package venmo
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+)
+
+// TransactionService fetches and normalizes pages of Venmo transactions.
+// Obtain one via Client.Transactions.
+type TransactionService struct {
+	client *Client
+}
+
+// ListOptions controls which page of transactions List fetches.
+type ListOptions struct {
+	// NextID is the pagination cursor returned by a previous call to List.
+	// Leave empty to fetch the first page.
+	NextID string
+}
+
+// List fetches a single page of transactions, returning the page, the
+// cursor for the next page (empty if this was the last page), and any
+// error. Use Pager to walk every page without managing the cursor by hand.
+func (s *TransactionService) List(ctx context.Context, opts ListOptions) ([]Transaction, string, error) {
+	resp, err := s.client.do(ctx, opts.NextID)
+	if err != nil {
+		return nil, "", err
+	}
+
+	txns := make([]Transaction, 0, len(resp.Stories))
+	for _, st := range resp.Stories {
+		txn, err := newTransaction(st)
+		if err != nil {
+			s.client.logger.Warn("skipping transaction", "id", st.ID, "error", err)
+			continue
+		}
+		txns = append(txns, txn)
+	}
+
+	return txns, resp.NextID, nil
+}
+
+// Pager returns a Pager that walks every page of transactions in order,
+// starting from the most recent.
+func (s *TransactionService) Pager(ctx context.Context) *Pager {
+	return &Pager{ctx: ctx, svc: s}
+}
+
+// do issues a single request to the stories endpoint and decodes the
+// response.
+func (c *Client) do(ctx context.Context, nextID string) (*storiesResponse, error) {
+	url := storiesURL + "&externalId=" + c.externalID
+	if nextID != "" {
+		url += "&nextId=" + nextID
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, fmt.Errorf("build request: %w", err)
+	}
+
+	req.Header.Set("accept", "*/*")
+	req.Header.Set("accept-language", "en-US,en;q=0.9")
+	req.Header.Set("cookie", c.cookie)
+	req.Header.Set("dnt", "1")
+	req.Header.Set("referer", "https://account.venmo.com/")
+	req.Header.Set("sec-ch-ua", `"Chromium";v="129", "Not=A?Brand";v="8"`)
+	req.Header.Set("sec-ch-ua-mobile", "?0")
+	req.Header.Set("sec-ch-ua-platform", `"macOS"`)
+	req.Header.Set("user-agent", "Mozilla/5.0")
+	if id, ok := requestIDFromContext(ctx); ok {
+		req.Header.Set("X-Request-Id", id)
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("do request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("read response body: %w", err)
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("fetch transactions: %d %s", resp.StatusCode, http.StatusText(resp.StatusCode))
+	}
+
+	var data storiesResponse
+	if err := json.Unmarshal(body, &data); err != nil {
+		return nil, fmt.Errorf("parse JSON response: %w", err)
+	}
+
+	return &data, nil
+}
@@ -0,0 +1,35 @@
+package venmo
+
+// story is the raw shape of a single entry in the Venmo stories feed, as
+// returned by the API. It is intentionally unexported: TransactionService
+// normalizes stories into Transaction values, which is the type callers
+// should depend on.
+type story struct {
+	ID     string `json:"id"`
+	Amount string `json:"amount"`
+	Date   string `json:"date"` // RFC3339, occasionally without a timezone offset
+	Type   string `json:"type"` // "transfer" or "payment"
+	Note   struct {
+		Name    string `json:"name,omitempty"`
+		Content string `json:"content,omitempty"`
+	} `json:"note"`
+	Title struct {
+		Payload struct {
+			SubType string `json:"subType,omitempty"` // standardTransfer or p2p
+		} `json:"payload,omitempty"`
+		Receiver struct {
+			DisplayName string `json:"displayName,omitempty"`
+			Username    string `json:"username,omitempty"`
+		} `json:"receiver,omitempty"`
+		Sender struct {
+			DisplayName string `json:"displayName,omitempty"`
+			Username    string `json:"username,omitempty"`
+		} `json:"sender,omitempty"`
+	} `json:"title,omitempty"`
+}
+
+// storiesResponse is the top-level shape of a stories API page.
+type storiesResponse struct {
+	NextID  string  `json:"nextId"`
+	Stories []story `json:"stories"`
+}
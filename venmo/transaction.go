@@ -0,0 +1,100 @@
+package venmo
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Transaction is a normalized Venmo activity record, derived from the raw
+// story the API returns. Amount is signed: negative for money leaving the
+// account, positive for money received.
+type Transaction struct {
+	ID     string    `json:"id"`
+	Amount float64   `json:"amount"`
+	Date   time.Time `json:"date"`
+	Type   string    `json:"type"` // "Transfer" or "Payment"
+	Note   string    `json:"note"`
+	// Counterparty is the other party to a payment (the receiver's or
+	// sender's display name, or username if they have none set). It is
+	// empty for transfers, which have no Venmo counterparty.
+	Counterparty string `json:"counterparty,omitempty"`
+}
+
+// newTransaction normalizes a raw story into a Transaction. It returns an
+// error if the story's date can't be parsed, since every other field
+// degrades gracefully.
+func newTransaction(s story) (Transaction, error) {
+	date, err := parseStoryDate(s.Date)
+	if err != nil {
+		return Transaction{}, fmt.Errorf("parse transaction date: %w", err)
+	}
+
+	txn := Transaction{
+		ID:   s.ID,
+		Date: date,
+	}
+
+	switch {
+	case strings.Contains(strings.ToLower(s.Type), "transfer"):
+		txn.Type = "Transfer"
+		txn.Amount = parseStoryAmount(s.Amount)
+		txn.Note = fmt.Sprintf("Transfer %s | %s", s.Note.Name, s.Amount)
+	case s.Type == "payment":
+		txn.Type = "Payment"
+		txn.Amount = parseStoryAmount(s.Amount)
+		txn.Counterparty, txn.Note = paymentNote(s)
+	}
+
+	return txn, nil
+}
+
+// parseStoryDate parses the date format used by the stories API, falling
+// back to a timezone-less variant that occasionally appears.
+func parseStoryDate(raw string) (time.Time, error) {
+	if t, err := time.Parse(time.RFC3339, raw); err == nil {
+		return t, nil
+	}
+	return time.Parse("2006-01-02T15:04:05", raw)
+}
+
+// parseStoryAmount parses amounts like "+$12.34" or "-$5.00" into a signed
+// float. Unparseable amounts are reported as 0 rather than failing the
+// whole transaction.
+func parseStoryAmount(raw string) float64 {
+	cleaned := raw
+	for _, c := range []string{"$", ",", "+"} {
+		cleaned = strings.ReplaceAll(cleaned, c, "")
+	}
+	negative := strings.Contains(cleaned, "-")
+	cleaned = strings.ReplaceAll(cleaned, "-", "")
+	cleaned = strings.TrimSpace(cleaned)
+
+	amount, err := strconv.ParseFloat(cleaned, 64)
+	if err != nil {
+		return 0
+	}
+	if negative {
+		amount = -amount
+	}
+	return amount
+}
+
+// paymentNote returns the counterparty to a payment (preferring their
+// display name over their username) and a human-readable note identifying
+// them alongside the payment's memo.
+func paymentNote(s story) (counterparty, note string) {
+	if s.Title.Sender.DisplayName == "you" {
+		receiver := s.Title.Receiver.DisplayName
+		if receiver == "" {
+			receiver = s.Title.Receiver.Username
+		}
+		return receiver, fmt.Sprintf("To %s | %s", receiver, s.Note.Content)
+	}
+	sender := s.Title.Sender.DisplayName
+	if sender == "" {
+		sender = s.Title.Sender.Username
+	}
+	return sender, fmt.Sprintf("From %s | %s", sender, s.Note.Content)
+}
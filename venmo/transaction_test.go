@@ -0,0 +1,43 @@
+package venmo
+
+import "testing"
+
+func TestNewTransaction_TransferParsesAmount(t *testing.T) {
+	s := story{
+		ID:     "1",
+		Amount: "-$50.00",
+		Date:   "2026-07-20T10:00:00Z",
+		Type:   "transfer",
+	}
+	s.Note.Name = "standard transfer"
+
+	txn, err := newTransaction(s)
+	if err != nil {
+		t.Fatalf("newTransaction() error = %v", err)
+	}
+	if txn.Amount != -50 {
+		t.Errorf("Amount = %v, want -50", txn.Amount)
+	}
+}
+
+func TestNewTransaction_PaymentParsesAmount(t *testing.T) {
+	s := story{
+		ID:     "2",
+		Amount: "+$12.34",
+		Date:   "2026-07-20T10:00:00Z",
+		Type:   "payment",
+	}
+	s.Title.Sender.DisplayName = "you"
+	s.Title.Receiver.DisplayName = "Bob"
+
+	txn, err := newTransaction(s)
+	if err != nil {
+		t.Fatalf("newTransaction() error = %v", err)
+	}
+	if txn.Amount != 12.34 {
+		t.Errorf("Amount = %v, want 12.34", txn.Amount)
+	}
+	if txn.Counterparty != "Bob" {
+		t.Errorf("Counterparty = %q, want %q", txn.Counterparty, "Bob")
+	}
+}
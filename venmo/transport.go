@@ -0,0 +1,188 @@
+package venmo
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"math"
+	"math/rand"
+	"net/http"
+	"strconv"
+	"time"
+
+	"golang.org/x/time/rate"
+)
+
+// RetryConfig controls the retry, backoff, rate limiting, and per-request
+// timeout behavior of a transport built with NewRetryingTransport.
+type RetryConfig struct {
+	// MaxAttempts is the maximum number of attempts per request, including
+	// the first. Values less than 1 are treated as 1 (no retries).
+	MaxAttempts int
+	// BaseDelay is the backoff delay before the first retry.
+	BaseDelay time.Duration
+	// MaxDelay caps the backoff delay.
+	MaxDelay time.Duration
+	// RequestTimeout bounds a single attempt, independent of any deadline
+	// on the caller's context covering the whole run. Zero disables it.
+	RequestTimeout time.Duration
+	// RateLimit caps requests per second across all attempts. Zero or
+	// negative disables the limiter.
+	RateLimit float64
+}
+
+// DefaultRetryConfig matches Venmo's observed rate-limit behavior: five
+// attempts, starting at a 500ms backoff and doubling up to a 30s cap.
+var DefaultRetryConfig = RetryConfig{
+	MaxAttempts:    5,
+	BaseDelay:      500 * time.Millisecond,
+	MaxDelay:       30 * time.Second,
+	RequestTimeout: 30 * time.Second,
+}
+
+// retryingTransport retries requests that fail with a transient error (429,
+// 5xx, or a network error) using exponential backoff with full jitter, and
+// optionally throttles outgoing requests to a fixed rate.
+type retryingTransport struct {
+	next    http.RoundTripper
+	cfg     RetryConfig
+	limiter *rate.Limiter
+}
+
+// NewRetryingTransport wraps next (http.DefaultTransport if nil) with retry,
+// rate limiting, and per-request timeout behavior.
+func NewRetryingTransport(next http.RoundTripper, cfg RetryConfig) http.RoundTripper {
+	if next == nil {
+		next = http.DefaultTransport
+	}
+	t := &retryingTransport{next: next, cfg: cfg}
+	if cfg.RateLimit > 0 {
+		t.limiter = rate.NewLimiter(rate.Limit(cfg.RateLimit), 1)
+	}
+	return t
+}
+
+// RoundTrip implements http.RoundTripper.
+func (t *retryingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	maxAttempts := t.cfg.MaxAttempts
+	if maxAttempts < 1 {
+		maxAttempts = 1
+	}
+	callerCtx := req.Context()
+
+	var lastErr error
+	for attempt := 0; attempt < maxAttempts; attempt++ {
+		if t.limiter != nil {
+			if err := t.limiter.Wait(callerCtx); err != nil {
+				return nil, err
+			}
+		}
+
+		resp, err := t.attempt(req)
+		switch {
+		case err != nil:
+			lastErr = err
+			if !isRetryableError(callerCtx) {
+				return nil, err
+			}
+		case !isRetryableStatus(resp.StatusCode):
+			return resp, nil
+		default:
+			lastErr = fmt.Errorf("retryable response status: %d %s", resp.StatusCode, http.StatusText(resp.StatusCode))
+		}
+
+		delay := backoffDelay(t.cfg, attempt)
+		if err == nil {
+			if ra := retryAfter(resp); ra > 0 {
+				delay = ra
+			}
+			resp.Body.Close()
+		}
+
+		if attempt == maxAttempts-1 {
+			break
+		}
+
+		select {
+		case <-callerCtx.Done():
+			return nil, callerCtx.Err()
+		case <-time.After(delay):
+		}
+	}
+	return nil, lastErr
+}
+
+// attempt issues a single request attempt, bounding it with
+// cfg.RequestTimeout independent of the overall run's context deadline. The
+// timeout's cancel func is deferred to the response body's Close, so
+// reading the body isn't cut short.
+func (t *retryingTransport) attempt(req *http.Request) (*http.Response, error) {
+	ctx := req.Context()
+	cancel := context.CancelFunc(func() {})
+	if t.cfg.RequestTimeout > 0 {
+		ctx, cancel = context.WithTimeout(ctx, t.cfg.RequestTimeout)
+		req = req.WithContext(ctx)
+	}
+
+	resp, err := t.next.RoundTrip(req)
+	if err != nil {
+		cancel()
+		return nil, err
+	}
+	resp.Body = &cancelOnCloseBody{ReadCloser: resp.Body, cancel: cancel}
+	return resp, nil
+}
+
+// cancelOnCloseBody cancels its associated request context once the
+// response body is closed, rather than as soon as RoundTrip returns.
+type cancelOnCloseBody struct {
+	io.ReadCloser
+	cancel context.CancelFunc
+}
+
+func (b *cancelOnCloseBody) Close() error {
+	defer b.cancel()
+	return b.ReadCloser.Close()
+}
+
+func isRetryableStatus(code int) bool {
+	return code == http.StatusTooManyRequests || code >= 500
+}
+
+// isRetryableError reports whether a RoundTrip error should be retried. A
+// context.DeadlineExceeded from attempt's per-request timeout is retryable;
+// the caller's own context being done (Ctrl-C, or its own deadline) is not,
+// since retrying can't outlast a deadline the caller itself imposed.
+func isRetryableError(callerCtx context.Context) bool {
+	return callerCtx.Err() == nil
+}
+
+// backoffDelay computes an exponential backoff with full jitter: a random
+// duration between 0 and cfg.BaseDelay*2^attempt, capped at cfg.MaxDelay.
+func backoffDelay(cfg RetryConfig, attempt int) time.Duration {
+	delay := cfg.BaseDelay * time.Duration(math.Pow(2, float64(attempt)))
+	if cfg.MaxDelay > 0 && delay > cfg.MaxDelay {
+		delay = cfg.MaxDelay
+	}
+	if delay <= 0 {
+		return 0
+	}
+	return time.Duration(rand.Int63n(int64(delay) + 1))
+}
+
+// retryAfter parses a Retry-After response header, supporting both the
+// delay-seconds and HTTP-date forms. It returns 0 if the header is absent or
+// unparseable.
+func retryAfter(resp *http.Response) time.Duration {
+	raw := resp.Header.Get("Retry-After")
+	if raw == "" {
+		return 0
+	}
+	if secs, err := strconv.Atoi(raw); err == nil {
+		return time.Duration(secs) * time.Second
+	}
+	if t, err := time.Parse(http.TimeFormat, raw); err == nil {
+		return time.Until(t)
+	}
+	return 0
+}
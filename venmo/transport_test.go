@@ -0,0 +1,156 @@
+package venmo
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+)
+
+// stubRoundTripper returns its configured results in order, one per call.
+type stubRoundTripper struct {
+	results []stubResult
+	calls   int
+}
+
+type stubResult struct {
+	resp *http.Response
+	err  error
+}
+
+func (s *stubRoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	if s.calls >= len(s.results) {
+		return nil, errors.New("stub: no more results configured")
+	}
+	r := s.results[s.calls]
+	s.calls++
+	return r.resp, r.err
+}
+
+// countingBody tracks how many times Close was called, so tests can assert
+// response bodies aren't leaked.
+type countingBody struct {
+	*strings.Reader
+	closes int
+}
+
+func (b *countingBody) Close() error {
+	b.closes++
+	return nil
+}
+
+func stubResp(status int) (*http.Response, *countingBody) {
+	body := &countingBody{Reader: strings.NewReader("")}
+	return &http.Response{StatusCode: status, Header: make(http.Header), Body: body}, body
+}
+
+func testRetryConfig() RetryConfig {
+	return RetryConfig{MaxAttempts: 3, BaseDelay: time.Millisecond, MaxDelay: 5 * time.Millisecond}
+}
+
+func TestRetryingTransport_RetriesServerErrorThenSucceeds(t *testing.T) {
+	resp500, body500 := stubResp(http.StatusInternalServerError)
+	resp200, _ := stubResp(http.StatusOK)
+	stub := &stubRoundTripper{results: []stubResult{{resp: resp500}, {resp: resp200}}}
+
+	rt := NewRetryingTransport(stub, testRetryConfig())
+	req, _ := http.NewRequest(http.MethodGet, "http://example.invalid", nil)
+
+	resp, err := rt.RoundTrip(req)
+	if err != nil {
+		t.Fatalf("RoundTrip() error = %v", err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		t.Errorf("StatusCode = %d, want %d", resp.StatusCode, http.StatusOK)
+	}
+	if stub.calls != 2 {
+		t.Errorf("calls = %d, want 2", stub.calls)
+	}
+	if body500.closes != 1 {
+		t.Errorf("retried 500 response body closes = %d, want 1", body500.closes)
+	}
+}
+
+func TestRetryingTransport_NonRetryableStatusReturnsImmediately(t *testing.T) {
+	resp404, _ := stubResp(http.StatusNotFound)
+	stub := &stubRoundTripper{results: []stubResult{{resp: resp404}}}
+
+	rt := NewRetryingTransport(stub, testRetryConfig())
+	req, _ := http.NewRequest(http.MethodGet, "http://example.invalid", nil)
+
+	resp, err := rt.RoundTrip(req)
+	if err != nil {
+		t.Fatalf("RoundTrip() error = %v", err)
+	}
+	if resp.StatusCode != http.StatusNotFound {
+		t.Errorf("StatusCode = %d, want %d", resp.StatusCode, http.StatusNotFound)
+	}
+	if stub.calls != 1 {
+		t.Errorf("calls = %d, want 1 (no retry on a non-retryable status)", stub.calls)
+	}
+}
+
+func TestRetryingTransport_ExhaustsRetriesAndClosesEveryBody(t *testing.T) {
+	resp1, body1 := stubResp(http.StatusTooManyRequests)
+	resp2, body2 := stubResp(http.StatusTooManyRequests)
+	stub := &stubRoundTripper{results: []stubResult{{resp: resp1}, {resp: resp2}}}
+
+	rt := NewRetryingTransport(stub, RetryConfig{MaxAttempts: 2, BaseDelay: time.Millisecond, MaxDelay: 5 * time.Millisecond})
+	req, _ := http.NewRequest(http.MethodGet, "http://example.invalid", nil)
+
+	_, err := rt.RoundTrip(req)
+	if err == nil {
+		t.Fatal("RoundTrip() error = nil, want an error once retries are exhausted")
+	}
+	if body1.closes != 1 || body2.closes != 1 {
+		t.Errorf("exhausted attempt body closes = %d, %d, want 1, 1", body1.closes, body2.closes)
+	}
+	if stub.calls != 2 {
+		t.Errorf("calls = %d, want 2", stub.calls)
+	}
+}
+
+func TestRetryingTransport_CallerCancellationStopsRetrying(t *testing.T) {
+	stub := &stubRoundTripper{results: []stubResult{
+		{err: errors.New("network blip")},
+		{err: errors.New("network blip")},
+	}}
+	rt := NewRetryingTransport(stub, RetryConfig{MaxAttempts: 5, BaseDelay: time.Millisecond, MaxDelay: 5 * time.Millisecond})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	req, _ := http.NewRequest(http.MethodGet, "http://example.invalid", nil)
+	req = req.WithContext(ctx)
+
+	_, err := rt.RoundTrip(req)
+	if err == nil {
+		t.Fatal("RoundTrip() error = nil, want an error for an already-cancelled caller context")
+	}
+	if stub.calls != 1 {
+		t.Errorf("calls = %d, want 1 (should not retry once the caller's context is done)", stub.calls)
+	}
+}
+
+func TestRetryingTransport_NetworkErrorIsRetried(t *testing.T) {
+	resp200, _ := stubResp(http.StatusOK)
+	stub := &stubRoundTripper{results: []stubResult{
+		{err: errors.New("connection reset")},
+		{resp: resp200},
+	}}
+
+	rt := NewRetryingTransport(stub, testRetryConfig())
+	req, _ := http.NewRequest(http.MethodGet, "http://example.invalid", nil)
+
+	resp, err := rt.RoundTrip(req)
+	if err != nil {
+		t.Fatalf("RoundTrip() error = %v", err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		t.Errorf("StatusCode = %d, want %d", resp.StatusCode, http.StatusOK)
+	}
+	if stub.calls != 2 {
+		t.Errorf("calls = %d, want 2", stub.calls)
+	}
+}